@@ -0,0 +1,203 @@
+package catalog
+
+import (
+	"fmt"
+	"io"
+)
+
+// ProductCatalog manages the product inventory and sorting
+type ProductCatalog struct {
+	products       []Product
+	registry       *SorterRegistry
+	filterRegistry *FilterRegistry
+}
+
+// NewProductCatalog creates a new product catalog
+func NewProductCatalog(products []Product) *ProductCatalog {
+	return &ProductCatalog{
+		products:       products,
+		registry:       NewSorterRegistry(),
+		filterRegistry: NewFilterRegistry(),
+	}
+}
+
+// GetSortedProducts returns products sorted according to the specified method
+func (c *ProductCatalog) GetSortedProducts(sorterName string) ([]Product, error) {
+	sorter, exists := c.registry.GetSorter(sorterName)
+	if !exists {
+		return nil, fmt.Errorf("sorter '%s' not found", sorterName)
+	}
+
+	return sorter.Sort(c.products), nil
+}
+
+// GetSortedProductsBy returns products sorted according to a composite sort
+// spec, e.g. "-price,name,+created". See BuildComposite for the spec syntax.
+func (c *ProductCatalog) GetSortedProductsBy(spec string) ([]Product, error) {
+	sorter, err := BuildComposite(c.registry, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return sorter.Sort(c.products), nil
+}
+
+// Render writes products sorted by sorterName to w, one line per product
+// behind a header line. If the sorter implements FormattedSorter, its
+// ProductFormatter decides how the header and rows look; otherwise Render
+// falls back to printing each product's name.
+func (c *ProductCatalog) Render(w io.Writer, sorterName string, products []Product) error {
+	sorter, exists := c.registry.GetSorter(sorterName)
+	if !exists {
+		return fmt.Errorf("sorter '%s' not found", sorterName)
+	}
+
+	var formatter ProductFormatter = nameOnlyFormatter{sorterName: sorterName}
+	if fs, ok := sorter.(FormattedSorter); ok {
+		formatter = fs.Formatter()
+	}
+
+	if _, err := fmt.Fprintln(w, formatter.Header()); err != nil {
+		return err
+	}
+	for _, p := range products {
+		if _, err := fmt.Fprintln(w, formatter.Row(p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSortingLogic allows adding a new sorter to the catalog
+func (c *ProductCatalog) AddSortingLogic(sorter ProductSorter) {
+	c.registry.RegisterSorter(sorter)
+}
+
+// AvailableSorters returns the names of every sorter registered with the catalog.
+func (c *ProductCatalog) AvailableSorters() []string {
+	return c.registry.GetAvailableSorters()
+}
+
+// renamedSorter wraps a ProductSorter so it reports a caller-chosen name,
+// used to let RegisterCompositeSorter give a composite spec a friendly name.
+type renamedSorter struct {
+	ProductSorter
+	name string
+}
+
+// GetName returns the caller-chosen name rather than the wrapped sorter's own.
+func (r *renamedSorter) GetName() string {
+	return r.name
+}
+
+// RegisterCompositeSorter parses spec with BuildComposite and registers the
+// result under name, so it can later be retrieved with GetSortedProducts(name)
+// just like any other sorter. This is how runtime plugins (e.g. the server
+// package's POST /products/sorters) add a sorter from a declarative spec
+// instead of compiled Go code.
+func (c *ProductCatalog) RegisterCompositeSorter(name, spec string) error {
+	sorter, err := BuildComposite(c.registry, spec)
+	if err != nil {
+		return err
+	}
+
+	c.AddSortingLogic(&renamedSorter{ProductSorter: sorter, name: name})
+	return nil
+}
+
+// AddFilter registers a named, reusable filter that ProductQuery.FilterNames
+// can later refer to.
+func (c *ProductCatalog) AddFilter(name string, filter FilterFunc) {
+	c.filterRegistry.RegisterFilter(name, filter)
+}
+
+// ProductQuery bundles the filter -> sort -> paginate pipeline for a single
+// call to ProductCatalog.Query.
+type ProductQuery struct {
+	// Filters are applied directly; a product must satisfy all of them.
+	Filters []FilterFunc
+	// FilterNames looks up additional filters registered via AddFilter,
+	// so callers can reference them without holding onto the FilterFunc.
+	FilterNames []string
+	// Sort names a registered sorter, or is a composite sort spec such as
+	// "-price,name" (see BuildComposite). Empty means the matched products
+	// keep their catalog order.
+	Sort string
+	// Offset skips this many matched products before the page starts.
+	Offset int
+	// Limit caps the page size. Zero or negative means no limit.
+	Limit int
+}
+
+// QueryResult is the page returned by ProductCatalog.Query.
+type QueryResult struct {
+	Products []Product
+	Total    int
+	SortName string
+}
+
+// Query runs the filter -> sort -> paginate pipeline described by q against
+// the catalog and returns the matching page.
+func (c *ProductCatalog) Query(q ProductQuery) (QueryResult, error) {
+	filters := make([]FilterFunc, 0, len(q.Filters)+len(q.FilterNames))
+	filters = append(filters, q.Filters...)
+	for _, name := range q.FilterNames {
+		filter, ok := c.filterRegistry.GetFilter(name)
+		if !ok {
+			return QueryResult{}, fmt.Errorf("filter '%s' not found", name)
+		}
+		filters = append(filters, filter)
+	}
+
+	matched := make([]Product, 0, len(c.products))
+	for i := range c.products {
+		p := &c.products[i]
+
+		keep := true
+		for _, filter := range filters {
+			if !filter(p) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			matched = append(matched, *p)
+		}
+	}
+
+	sortName := ""
+	if q.Sort != "" {
+		sorter, exists := c.registry.GetSorter(q.Sort)
+		if !exists {
+			var err error
+			sorter, err = BuildComposite(c.registry, q.Sort)
+			if err != nil {
+				return QueryResult{}, err
+			}
+		}
+		matched = sorter.Sort(matched)
+		sortName = sorter.GetName()
+	}
+
+	total := len(matched)
+
+	offset := q.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+
+	end := total
+	if q.Limit > 0 && offset+q.Limit < end {
+		end = offset + q.Limit
+	}
+
+	return QueryResult{
+		Products: matched[offset:end],
+		Total:    total,
+		SortName: sortName,
+	}, nil
+}
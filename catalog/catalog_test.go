@@ -0,0 +1,124 @@
+package catalog
+
+import "testing"
+
+func TestQueryFiltersSortsAndPaginates(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+
+	result, err := catalog.Query(ProductQuery{
+		Filters: []FilterFunc{PriceRangeFilter(5, 10)},
+		Sort:    "price",
+		Limit:   2,
+	})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+
+	if result.Total != 4 {
+		t.Errorf("Total = %d, want 4", result.Total)
+	}
+	if len(result.Products) != 2 {
+		t.Fatalf("len(Products) = %d, want 2", len(result.Products))
+	}
+	if result.Products[0].Name != "Coffee Table" {
+		t.Errorf("Products[0].Name = %q, want %q", result.Products[0].Name, "Coffee Table")
+	}
+	if result.SortName != "price" {
+		t.Errorf("SortName = %q, want %q", result.SortName, "price")
+	}
+}
+
+func TestQueryOffsetPastTotalReturnsEmptyPage(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+
+	result, err := catalog.Query(ProductQuery{Offset: 100})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(result.Products) != 0 {
+		t.Errorf("len(Products) = %d, want 0", len(result.Products))
+	}
+	if result.Total != len(sampleProducts()) {
+		t.Errorf("Total = %d, want %d", result.Total, len(sampleProducts()))
+	}
+}
+
+func TestQueryUsesRegisteredFilterByName(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+	catalog.AddFilter("cheap", PriceRangeFilter(0, 8))
+
+	result, err := catalog.Query(ProductQuery{FilterNames: []string{"cheap"}})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(result.Products) != 1 || result.Products[0].Name != "Coffee Table" {
+		t.Errorf("Products = %+v, want only Coffee Table", result.Products)
+	}
+}
+
+func TestQueryUnknownFilterNameErrors(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+	if _, err := catalog.Query(ProductQuery{FilterNames: []string{"bogus"}}); err == nil {
+		t.Fatal("expected an error for an unregistered filter name, got nil")
+	}
+}
+
+func TestMinSalesRatioFilter(t *testing.T) {
+	products := sampleProducts()
+	filter := MinSalesRatioFilter(0.095) // only Oak Table's 50/500 = 0.1 ratio clears this bar
+
+	var names []string
+	for i := range products {
+		if filter(&products[i]) {
+			names = append(names, products[i].Name)
+		}
+	}
+
+	if len(names) != 1 || names[0] != "Oak Table" {
+		t.Errorf("filtered = %v, want [Oak Table]", names)
+	}
+}
+
+func TestNameRegexFilterRejectsInvalidPattern(t *testing.T) {
+	if _, err := NameRegexFilter("["); err == nil {
+		t.Fatal("expected an error for an invalid regex, got nil")
+	}
+}
+
+func TestCreatedAfterAndBeforeFilters(t *testing.T) {
+	after, err := CreatedAfterFilter("2013-01-01")
+	if err != nil {
+		t.Fatalf("CreatedAfterFilter returned error: %v", err)
+	}
+	before, err := CreatedBeforeFilter("2013-01-01")
+	if err != nil {
+		t.Fatalf("CreatedBeforeFilter returned error: %v", err)
+	}
+
+	products := sampleProducts()
+	afterCount, beforeCount := 0, 0
+	for i := range products {
+		if after(&products[i]) {
+			afterCount++
+		}
+		if before(&products[i]) {
+			beforeCount++
+		}
+	}
+
+	if afterCount+beforeCount != len(products) {
+		t.Errorf("after=%d before=%d should partition all %d products", afterCount, beforeCount, len(products))
+	}
+}
+
+func TestCreatedAfterAndBeforeFiltersAcceptNonDefaultLayouts(t *testing.T) {
+	cases := []string{"2013-01-01T00:00:00Z", "2013/01/01", "1357000800"}
+	for _, date := range cases {
+		if _, err := CreatedAfterFilter(date); err != nil {
+			t.Errorf("CreatedAfterFilter(%q) returned error: %v", date, err)
+		}
+		if _, err := CreatedBeforeFilter(date); err != nil {
+			t.Errorf("CreatedBeforeFilter(%q) returned error: %v", date, err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultDateLayouts are the layouts a fresh DateFormatRegistry accepts,
+// tried in order until one parses the date string.
+var defaultDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// DateFormatRegistry holds the layouts accepted for a Product's Created
+// date, so a caller whose feed uses an unusual format can register it
+// instead of pre-processing every record. A *DateFormatRegistry is safe for
+// concurrent use: defaultDateFormats is a package-level instance that
+// ParseDate reads on every request while RegisterDateFormat may append to
+// it concurrently.
+type DateFormatRegistry struct {
+	mu      sync.RWMutex
+	layouts []string
+}
+
+// NewDateFormatRegistry creates a registry seeded with defaultDateLayouts.
+func NewDateFormatRegistry() *DateFormatRegistry {
+	layouts := make([]string, len(defaultDateLayouts))
+	copy(layouts, defaultDateLayouts)
+	return &DateFormatRegistry{layouts: layouts}
+}
+
+// AddLayout registers an additional time.Parse layout to try.
+func (r *DateFormatRegistry) AddLayout(layout string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.layouts = append(r.layouts, layout)
+}
+
+// ParseDate parses s against every registered layout, falling back to
+// treating s as unix seconds if none of them match.
+func (r *DateFormatRegistry) ParseDate(s string) (time.Time, error) {
+	r.mu.RLock()
+	layouts := r.layouts
+	r.mu.RUnlock()
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("date %q does not match any registered layout", s)
+}
+
+// Parse parses a raw JSON token for a "created" field: either a quoted date
+// string (tried against every registered layout) or a bare JSON number
+// (treated as unix seconds).
+func (r *DateFormatRegistry) Parse(raw json.RawMessage) (time.Time, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return time.Time{}, fmt.Errorf("created date is empty")
+	}
+
+	if trimmed[0] != '"' {
+		return r.ParseDate(string(trimmed))
+	}
+
+	var s string
+	if err := json.Unmarshal(trimmed, &s); err != nil {
+		return time.Time{}, fmt.Errorf("invalid created date %s: %w", trimmed, err)
+	}
+
+	return r.ParseDate(s)
+}
+
+// defaultDateFormats is the registry Product.UnmarshalJSON and the Created*
+// filters use unless a caller registers additional layouts.
+var defaultDateFormats = NewDateFormatRegistry()
+
+// RegisterDateFormat adds layout to the default date format registry used
+// when decoding a Product's Created field.
+func RegisterDateFormat(layout string) {
+	defaultDateFormats.AddLayout(layout)
+}
@@ -0,0 +1,120 @@
+package catalog
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDateFormatRegistryParsesDefaultLayouts(t *testing.T) {
+	registry := NewDateFormatRegistry()
+
+	cases := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2019-01-04", time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"2019/01/04", time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"2019-01-04T15:04:05Z", time.Date(2019, 1, 4, 15, 4, 5, 0, time.UTC)},
+		{"1546617600", time.Date(2019, 1, 4, 16, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		got, err := registry.ParseDate(c.input)
+		if err != nil {
+			t.Fatalf("ParseDate(%q) returned error: %v", c.input, err)
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("ParseDate(%q) = %v, want %v", c.input, got, c.want)
+		}
+	}
+}
+
+func TestDateFormatRegistryRejectsUnknownLayout(t *testing.T) {
+	registry := NewDateFormatRegistry()
+	if _, err := registry.ParseDate("04-Jan-2019"); err == nil {
+		t.Fatal("expected an error for an unregistered layout, got nil")
+	}
+}
+
+func TestDateFormatRegistryAddLayoutExtendsAcceptedFormats(t *testing.T) {
+	registry := NewDateFormatRegistry()
+	registry.AddLayout("02-Jan-2006")
+
+	got, err := registry.ParseDate("04-Jan-2019")
+	if err != nil {
+		t.Fatalf("ParseDate returned error after AddLayout: %v", err)
+	}
+	want := time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ParseDate = %v, want %v", got, want)
+	}
+}
+
+func TestProductUnmarshalJSONParsesMixedDateFormats(t *testing.T) {
+	raw := `[
+		{"id": 1, "name": "A", "price": 1, "created": "2019-01-04", "sales_count": 1, "views_count": 1},
+		{"id": 2, "name": "B", "price": 1, "created": "2012/01/04", "sales_count": 1, "views_count": 1},
+		{"id": 3, "name": "C", "price": 1, "created": "2014-05-28T00:00:00Z", "sales_count": 1, "views_count": 1}
+	]`
+
+	var products []Product
+	if err := json.Unmarshal([]byte(raw), &products); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	sorter := NewNewestFirstSorter()
+	sorted := sorter.Sort(products)
+
+	want := []string{"A", "C", "B"}
+	for i, name := range want {
+		if sorted[i].Name != name {
+			t.Errorf("position %d: got %q, want %q", i, sorted[i].Name, name)
+		}
+	}
+}
+
+func TestProductUnmarshalJSONRejectsUnparseableDate(t *testing.T) {
+	raw := `{"id": 1, "name": "A", "price": 1, "created": "not-a-date", "sales_count": 1, "views_count": 1}`
+
+	var p Product
+	if err := json.Unmarshal([]byte(raw), &p); err == nil {
+		t.Fatal("expected an error for an unparseable created date, got nil")
+	}
+}
+
+func TestMigrateLegacyFeedConvertsStringDates(t *testing.T) {
+	raw := `[{"id": 1, "name": "A", "price": 1, "created": "2019-01-04", "sales_count": 1, "views_count": 1}]`
+
+	products, err := MigrateLegacyFeed([]byte(raw))
+	if err != nil {
+		t.Fatalf("MigrateLegacyFeed returned error: %v", err)
+	}
+
+	want := time.Date(2019, 1, 4, 0, 0, 0, 0, time.UTC)
+	if len(products) != 1 || !products[0].Created.Equal(want) {
+		t.Errorf("products = %+v, want Created = %v", products, want)
+	}
+}
+
+// TestDateFormatRegistryConcurrentAddAndParseIsRaceFree guards against
+// AddLayout appending to the shared layouts slice while ParseDate ranges
+// over it concurrently (run with -race to catch a regression).
+func TestDateFormatRegistryConcurrentAddAndParseIsRaceFree(t *testing.T) {
+	registry := NewDateFormatRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			registry.AddLayout("02-Jan-2006")
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = registry.ParseDate("2019-01-04")
+		}()
+	}
+	wg.Wait()
+}
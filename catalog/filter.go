@@ -0,0 +1,117 @@
+package catalog
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// FilterFunc is a predicate over a Product, used to build a filtered page
+// for ProductCatalog.Query.
+type FilterFunc func(p *Product) bool
+
+// PriceRangeFilter builds a filter that keeps products priced within
+// [min, max] inclusive. Pass a negative max to leave the upper bound open.
+func PriceRangeFilter(min, max float64) FilterFunc {
+	return func(p *Product) bool {
+		if p.Price < min {
+			return false
+		}
+		if max >= 0 && p.Price > max {
+			return false
+		}
+		return true
+	}
+}
+
+// NameRegexFilter builds a filter that keeps products whose name matches pattern.
+func NameRegexFilter(pattern string) (FilterFunc, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name filter pattern %q: %w", pattern, err)
+	}
+
+	return func(p *Product) bool {
+		return re.MatchString(p.Name)
+	}, nil
+}
+
+// CreatedAfterFilter builds a filter that keeps products created strictly
+// after date, parsed against defaultDateFormats so it accepts any layout
+// Product.Created itself accepts (RFC3339, "2006-01-02", "2006/01/02", or
+// unix seconds).
+func CreatedAfterFilter(date string) (FilterFunc, error) {
+	after, err := defaultDateFormats.ParseDate(date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created-after date %q: %w", date, err)
+	}
+
+	return func(p *Product) bool {
+		return p.Created.After(after)
+	}, nil
+}
+
+// CreatedBeforeFilter builds a filter that keeps products created strictly
+// before date, parsed against defaultDateFormats so it accepts any layout
+// Product.Created itself accepts (RFC3339, "2006-01-02", "2006/01/02", or
+// unix seconds).
+func CreatedBeforeFilter(date string) (FilterFunc, error) {
+	before, err := defaultDateFormats.ParseDate(date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created-before date %q: %w", date, err)
+	}
+
+	return func(p *Product) bool {
+		return p.Created.Before(before)
+	}, nil
+}
+
+// MinSalesRatioFilter builds a filter that keeps products whose sales-per-view
+// ratio is at least min. Products with zero views never pass.
+func MinSalesRatioFilter(min float64) FilterFunc {
+	return func(p *Product) bool {
+		if p.ViewsCount == 0 {
+			return false
+		}
+		return float64(p.SalesCount)/float64(p.ViewsCount) >= min
+	}
+}
+
+// FilterRegistry manages named, reusable filters, mirroring SorterRegistry.
+// A *FilterRegistry is safe for concurrent use, since a server can register
+// a filter while other requests are reading it.
+type FilterRegistry struct {
+	mu      sync.RWMutex
+	filters map[string]FilterFunc
+}
+
+// NewFilterRegistry creates an empty filter registry.
+func NewFilterRegistry() *FilterRegistry {
+	return &FilterRegistry{filters: make(map[string]FilterFunc)}
+}
+
+// RegisterFilter adds a new named filter to the registry.
+func (r *FilterRegistry) RegisterFilter(name string, filter FilterFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.filters[name] = filter
+}
+
+// GetFilter retrieves a filter by name.
+func (r *FilterRegistry) GetFilter(name string) (FilterFunc, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	filter, exists := r.filters[name]
+	return filter, exists
+}
+
+// GetAvailableFilters returns a list of registered filter names.
+func (r *FilterRegistry) GetAvailableFilters() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var names []string
+	for name := range r.filters {
+		names = append(names, name)
+	}
+	return names
+}
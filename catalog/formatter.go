@@ -0,0 +1,103 @@
+package catalog
+
+import "fmt"
+
+// ProductFormatter renders a page of sorted products for display, so a
+// caller like Render doesn't need to know how each sort wants its rows to
+// look.
+type ProductFormatter interface {
+	// Header returns a line printed once, before any rows.
+	Header() string
+	// Row returns the line printed for a single product.
+	Row(p Product) string
+}
+
+// FormattedSorter is implemented by a ProductSorter that brings its own
+// ProductFormatter, letting Render pick a display format without switching
+// on the sorter's name.
+type FormattedSorter interface {
+	Formatter() ProductFormatter
+}
+
+// formattedSorter pairs a ProductSorter with a ProductFormatter, so a sorter
+// built from a bare comparator (NewBaseSorter) can opt into Render without a
+// bespoke type.
+type formattedSorter struct {
+	ProductSorter
+	formatter ProductFormatter
+}
+
+// NewFormattedSorter wraps sorter so it reports formatter from Formatter(),
+// making it a FormattedSorter.
+func NewFormattedSorter(sorter ProductSorter, formatter ProductFormatter) ProductSorter {
+	return &formattedSorter{ProductSorter: sorter, formatter: formatter}
+}
+
+// Formatter returns the formatter this sorter was built with.
+func (f *formattedSorter) Formatter() ProductFormatter {
+	return f.formatter
+}
+
+// priceFormatter is the default ProductFormatter for NewPriceSorter.
+type priceFormatter struct{}
+
+func (priceFormatter) Header() string {
+	return fmt.Sprintf("Products sorted by %s:", BY_PRICE_HIGH_TO_LOW)
+}
+func (priceFormatter) Row(p Product) string {
+	return fmt.Sprintf("- %s: $%.2f", p.Name, p.Price)
+}
+
+// popularityFormatter is the default ProductFormatter for NewSalesPerViewSorter.
+type popularityFormatter struct{}
+
+func (popularityFormatter) Header() string {
+	return fmt.Sprintf("Products sorted by %s:", BY_POPULARITY)
+}
+func (popularityFormatter) Row(p Product) string {
+	ratio := float64(p.SalesCount) / float64(p.ViewsCount)
+	return fmt.Sprintf("- %s: %.5f (Sales: %d, Views: %d)", p.Name, ratio, p.SalesCount, p.ViewsCount)
+}
+
+// newestFormatter is the default ProductFormatter for NewNewestFirstSorter.
+type newestFormatter struct{}
+
+func (newestFormatter) Header() string { return fmt.Sprintf("Products sorted by %s:", BY_NEWSET) }
+func (newestFormatter) Row(p Product) string {
+	return fmt.Sprintf("- %s: %s", p.Name, p.Created.Format("2006-01-02"))
+}
+
+// alphabeticalFormatter is the default ProductFormatter for an alphabetical
+// sorter built with BY_APLHABETS, e.g. the one main demonstrates registering
+// at runtime.
+type alphabeticalFormatter struct{}
+
+func (alphabeticalFormatter) Header() string {
+	return fmt.Sprintf("Products sorted using %s method:", BY_APLHABETS)
+}
+func (alphabeticalFormatter) Row(p Product) string {
+	return fmt.Sprintf("- %s", p.Name)
+}
+
+// PriceFormatter returns the default ProductFormatter for a price sorter.
+func PriceFormatter() ProductFormatter { return priceFormatter{} }
+
+// PopularityFormatter returns the default ProductFormatter for a
+// sales-per-view sorter.
+func PopularityFormatter() ProductFormatter { return popularityFormatter{} }
+
+// NewestFormatter returns the default ProductFormatter for a newest-first sorter.
+func NewestFormatter() ProductFormatter { return newestFormatter{} }
+
+// AlphabeticalFormatter returns the default ProductFormatter for an
+// alphabetical sorter.
+func AlphabeticalFormatter() ProductFormatter { return alphabeticalFormatter{} }
+
+// nameOnlyFormatter is Render's fallback for a sorter that doesn't implement
+// FormattedSorter.
+type nameOnlyFormatter struct{ sorterName string }
+
+func (f nameOnlyFormatter) Header() string {
+	return fmt.Sprintf("Products sorted by %s:", f.sorterName)
+}
+func (nameOnlyFormatter) Row(p Product) string { return fmt.Sprintf("- %s", p.Name) }
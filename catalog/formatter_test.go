@@ -0,0 +1,54 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRenderUsesSorterFormatter(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+
+	sorted, err := catalog.GetSortedProducts(BY_PRICE_HIGH_TO_LOW)
+	if err != nil {
+		t.Fatalf("GetSortedProducts returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := catalog.Render(&buf, BY_PRICE_HIGH_TO_LOW, sorted); err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Products sorted by "+BY_PRICE_HIGH_TO_LOW) {
+		t.Errorf("output missing header: %q", out)
+	}
+	if !strings.Contains(out, "$5.00") {
+		t.Errorf("output missing formatted price row: %q", out)
+	}
+}
+
+func TestRenderFallsBackToNameOnlyFormatter(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+	catalog.AddSortingLogic(NewBaseSorter("id-order", func(p1, p2 *Product) int {
+		return p1.ID - p2.ID
+	}))
+
+	var buf bytes.Buffer
+	if err := catalog.Render(&buf, "id-order", sampleProducts()); err != nil {
+		t.Fatalf("Render returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Zebra Table") {
+		t.Errorf("output missing product name: %q", out)
+	}
+}
+
+func TestRenderRejectsUnknownSorter(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+	var buf bytes.Buffer
+	if err := catalog.Render(&buf, "bogus", sampleProducts()); err == nil {
+		t.Fatal("expected an error for an unknown sorter, got nil")
+	}
+}
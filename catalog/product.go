@@ -0,0 +1,288 @@
+package catalog
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// All the sorting methods names
+const (
+	BY_PRICE_HIGH_TO_LOW = "Price (Low to High)"
+	BY_POPULARITY        = "Popularity (Sales per View)"
+	BY_NEWSET            = "Newest First"
+	BY_APLHABETS         = "Alphabetical (Z to A)"
+)
+
+// Product represents a product in the catalog
+type Product struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	Price      float64   `json:"price"`
+	Created    time.Time `json:"created"`
+	SalesCount int       `json:"sales_count"`
+	ViewsCount int       `json:"views_count"`
+}
+
+// productJSON mirrors Product but keeps Created as a raw token, so
+// UnmarshalJSON can hand it to a DateFormatRegistry instead of forcing one
+// fixed layout.
+type productJSON struct {
+	ID         int             `json:"id"`
+	Name       string          `json:"name"`
+	Price      float64         `json:"price"`
+	Created    json.RawMessage `json:"created"`
+	SalesCount int             `json:"sales_count"`
+	ViewsCount int             `json:"views_count"`
+}
+
+// UnmarshalJSON decodes a Product, parsing Created against
+// defaultDateFormats so a feed's date layout doesn't have to match exactly.
+// A bad date is reported here, at decode time, rather than silently as a
+// zero time later at sort time.
+func (p *Product) UnmarshalJSON(data []byte) error {
+	var raw productJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	created, err := defaultDateFormats.Parse(raw.Created)
+	if err != nil {
+		return fmt.Errorf("product %d (%s): %w", raw.ID, raw.Name, err)
+	}
+
+	p.ID = raw.ID
+	p.Name = raw.Name
+	p.Price = raw.Price
+	p.Created = created
+	p.SalesCount = raw.SalesCount
+	p.ViewsCount = raw.ViewsCount
+	return nil
+}
+
+// legacyProduct is the pre-typed-date shape of a product feed, where Created
+// was a plain string.
+type legacyProduct struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Price      float64 `json:"price"`
+	Created    string  `json:"created"`
+	SalesCount int     `json:"sales_count"`
+	ViewsCount int     `json:"views_count"`
+}
+
+// MigrateLegacyFeed converts a JSON array of products whose "created" field
+// is a plain date string into typed Products, using defaultDateFormats to
+// parse each date. Use this once to migrate an old feed; new feeds can be
+// decoded directly into []Product.
+func MigrateLegacyFeed(data []byte) ([]Product, error) {
+	var legacy []legacyProduct
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return nil, fmt.Errorf("decoding legacy product feed: %w", err)
+	}
+
+	products := make([]Product, len(legacy))
+	for i, lp := range legacy {
+		created, err := defaultDateFormats.ParseDate(lp.Created)
+		if err != nil {
+			return nil, fmt.Errorf("product %d (%s): %w", lp.ID, lp.Name, err)
+		}
+
+		products[i] = Product{
+			ID:         lp.ID,
+			Name:       lp.Name,
+			Price:      lp.Price,
+			Created:    created,
+			SalesCount: lp.SalesCount,
+			ViewsCount: lp.ViewsCount,
+		}
+	}
+
+	return products, nil
+}
+
+// ProductSorter is the Sorter[T] instantiated for Product, the first
+// consumer of the generic sorting framework in sorter.go.
+type ProductSorter = Sorter[Product]
+
+// SorterRegistry is the Registry[T] instantiated for Product.
+type SorterRegistry = Registry[Product]
+
+// NewPriceSorter creates a sorter that orders products by price, ascending.
+func NewPriceSorter() ProductSorter {
+	sorter := NewBaseSorter(BY_PRICE_HIGH_TO_LOW, func(p1, p2 *Product) int {
+		return cmp.Compare(p1.Price, p2.Price)
+	})
+	return NewFormattedSorter(sorter, PriceFormatter())
+}
+
+// NewSalesPerViewSorter creates a sorter that orders products by sales per
+// view ratio, descending (most popular first).
+func NewSalesPerViewSorter() ProductSorter {
+	sorter := NewBaseSorter(BY_POPULARITY, func(p1, p2 *Product) int {
+		ratio1 := float64(p1.SalesCount) / float64(p1.ViewsCount)
+		ratio2 := float64(p2.SalesCount) / float64(p2.ViewsCount)
+		return cmp.Compare(ratio2, ratio1) // descending order for popularity
+	})
+	return NewFormattedSorter(sorter, PopularityFormatter())
+}
+
+// NewNewestFirstSorter creates a sorter that orders products by creation
+// date, newest first.
+func NewNewestFirstSorter() ProductSorter {
+	sorter := NewBaseSorter(BY_NEWSET, func(p1, p2 *Product) int {
+		return cmp.Compare(p2.Created.Unix(), p1.Created.Unix())
+	})
+	return NewFormattedSorter(sorter, NewestFormatter())
+}
+
+// NewSorterRegistry creates a new sorter registry with default sorters
+func NewSorterRegistry() *SorterRegistry {
+	registry := NewRegistry[Product]()
+
+	// Register default sorters
+	registry.RegisterSorter(NewPriceSorter())
+	registry.RegisterSorter(NewSalesPerViewSorter())
+	registry.RegisterSorter(NewNewestFirstSorter())
+
+	return registry
+}
+
+// fieldComparator does a three-way comparison between two products,
+// returning a negative number if p1 sorts before p2, a positive number if
+// p1 sorts after p2, and 0 if the field is equal for both.
+type fieldComparator func(p1, p2 *Product) int
+
+// productFieldComparators maps the field keys accepted by a composite sort
+// spec (see BuildComposite) to a comparator for that field.
+var productFieldComparators = map[string]fieldComparator{
+	"id": func(p1, p2 *Product) int { return cmp.Compare(p1.ID, p2.ID) },
+	"name": func(p1, p2 *Product) int {
+		return strings.Compare(p1.Name, p2.Name)
+	},
+	"price": func(p1, p2 *Product) int {
+		return cmp.Compare(p1.Price, p2.Price)
+	},
+	"created": func(p1, p2 *Product) int {
+		return cmp.Compare(p1.Created.Unix(), p2.Created.Unix())
+	},
+	"sales": func(p1, p2 *Product) int { return cmp.Compare(p1.SalesCount, p2.SalesCount) },
+	"views": func(p1, p2 *Product) int { return cmp.Compare(p1.ViewsCount, p2.ViewsCount) },
+}
+
+// normalizeSortKey lowercases a sorter or field name and strips anything
+// that isn't a letter or digit, so "Popularity (Sales per View)" and
+// "popularity" resolve to the same composite sort key.
+func normalizeSortKey(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// unwrapBaseSorter peels off the wrapper types (formattedSorter,
+// renamedSorter) that embed a ProductSorter to add a formatter or rename a
+// sorter, returning the underlying *BaseSorter[Product] if there is one.
+func unwrapBaseSorter(sorter ProductSorter) (*BaseSorter[Product], bool) {
+	for {
+		switch v := sorter.(type) {
+		case *BaseSorter[Product]:
+			return v, true
+		case *formattedSorter:
+			sorter = v.ProductSorter
+		case *renamedSorter:
+			sorter = v.ProductSorter
+		default:
+			return nil, false
+		}
+	}
+}
+
+// resolveFieldComparator looks up a composite sort key, first against the
+// built-in product fields and then against any registered sorter whose name
+// normalizes to the same key (e.g. "Popularity (Sales per View)" -> "popularity").
+//
+// This is a free function rather than a Registry[Product] method because Go
+// generics don't allow adding specialized methods to an instantiated alias
+// like SorterRegistry.
+func resolveFieldComparator(r *SorterRegistry, key string) (fieldComparator, bool) {
+	if cmp, ok := productFieldComparators[key]; ok {
+		return cmp, true
+	}
+
+	var found fieldComparator
+	r.Range(func(name string, sorter Sorter[Product]) {
+		if found != nil || normalizeSortKey(name) != key {
+			return
+		}
+		if base, ok := unwrapBaseSorter(sorter); ok {
+			found = base.compare
+		}
+	})
+
+	return found, found != nil
+}
+
+// BuildComposite parses a comma-separated sort spec such as "-price,name,+created"
+// into a ProductSorter that compares products key by key, falling through to the
+// next key to break ties. A leading '-' sorts that key descending, '+' or no
+// prefix sorts ascending. Each token must name a known product field (see
+// productFieldComparators) or a registered sorter, and may not repeat.
+func BuildComposite(r *SorterRegistry, spec string) (ProductSorter, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("sort spec must not be empty")
+	}
+
+	tokens := strings.Split(spec, ",")
+	keys := make([]compositeKey[Product], 0, len(tokens))
+	seen := make(map[string]bool, len(tokens))
+	var normalized []string
+
+	for _, raw := range tokens {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			return nil, fmt.Errorf("sort spec %q contains an empty token", spec)
+		}
+
+		descending := false
+		switch raw[0] {
+		case '-':
+			descending = true
+			raw = raw[1:]
+		case '+':
+			raw = raw[1:]
+		}
+
+		key := normalizeSortKey(raw)
+		if key == "" {
+			return nil, fmt.Errorf("sort spec %q contains an empty token", spec)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("sort spec %q repeats key %q", spec, key)
+		}
+		seen[key] = true
+
+		fieldCmp, ok := resolveFieldComparator(r, key)
+		if !ok {
+			return nil, fmt.Errorf("sort spec %q references unknown key %q", spec, key)
+		}
+
+		keys = append(keys, compositeKey[Product]{token: key, descending: descending, compare: fieldCmp})
+		if descending {
+			normalized = append(normalized, "-"+key)
+		} else {
+			normalized = append(normalized, key)
+		}
+	}
+
+	return &compositeSorter[Product]{
+		name: strings.Join(normalized, ","),
+		keys: keys,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package catalog
+
+import (
+	"slices"
+	"sync"
+)
+
+// Sorter sorts a slice of T and exposes a human-readable name, identifying
+// the sort for registries, logs, and UI labels.
+type Sorter[T any] interface {
+	Sort(items []T) []T
+	GetName() string
+}
+
+// BaseSorter implements Sorter[T] from a three-way comparator, mirroring the
+// cmp.Compare / slices.SortStableFunc convention from the standard library
+// (Go 1.21+): negative if a sorts before b, positive if after, 0 if equal.
+// Comparators for several keys can be combined with cmp.Or.
+type BaseSorter[T any] struct {
+	name    string
+	compare func(a, b *T) int
+}
+
+// NewBaseSorter creates a Sorter[T] from a name and a three-way comparator.
+func NewBaseSorter[T any](name string, compare func(a, b *T) int) *BaseSorter[T] {
+	return &BaseSorter[T]{name: name, compare: compare}
+}
+
+// Sort sorts a copy of items using the comparator, leaving items untouched.
+func (s *BaseSorter[T]) Sort(items []T) []T {
+	result := make([]T, len(items))
+	copy(result, items)
+
+	slices.SortStableFunc(result, func(a, b T) int {
+		return s.compare(&a, &b)
+	})
+
+	return result
+}
+
+// GetName returns the name of the sorter.
+func (s *BaseSorter[T]) GetName() string {
+	return s.name
+}
+
+// compositeKey is a single, direction-aware key within a composite sort spec.
+type compositeKey[T any] struct {
+	token      string // normalized token, without its +/- prefix
+	descending bool
+	compare    func(a, b *T) int
+}
+
+// compositeSorter chains several compositeKeys together, falling through to
+// the next key to break ties, much like a multi-column ORDER BY.
+type compositeSorter[T any] struct {
+	name string
+	keys []compositeKey[T]
+}
+
+// Sort implements Sorter[T] by applying the composite keys in order, using
+// slices.SortStableFunc so any remaining ties keep their original order.
+func (s *compositeSorter[T]) Sort(items []T) []T {
+	result := make([]T, len(items))
+	copy(result, items)
+
+	slices.SortStableFunc(result, func(a, b T) int {
+		for _, key := range s.keys {
+			c := key.compare(&a, &b)
+			if c == 0 {
+				continue
+			}
+			if key.descending {
+				return -c
+			}
+			return c
+		}
+		return 0
+	})
+
+	return result
+}
+
+// GetName returns the normalized sort spec this sorter was built from.
+func (s *compositeSorter[T]) GetName() string {
+	return s.name
+}
+
+// Registry manages the set of sorters available for a given element type T.
+// A *Registry[T] is safe for concurrent use, since a server can register a
+// sorter (e.g. POST /products/sorters) while other requests are reading it.
+type Registry[T any] struct {
+	mu      sync.RWMutex
+	sorters map[string]Sorter[T]
+}
+
+// NewRegistry creates an empty registry for element type T.
+func NewRegistry[T any]() *Registry[T] {
+	return &Registry[T]{sorters: make(map[string]Sorter[T])}
+}
+
+// RegisterSorter adds a new sorter to the registry.
+func (r *Registry[T]) RegisterSorter(sorter Sorter[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sorters[sorter.GetName()] = sorter
+}
+
+// GetSorter retrieves a sorter by name.
+func (r *Registry[T]) GetSorter(name string) (Sorter[T], bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	sorter, exists := r.sorters[name]
+	return sorter, exists
+}
+
+// GetAvailableSorters returns a list of available sorter names.
+func (r *Registry[T]) GetAvailableSorters() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var names []string
+	for name := range r.sorters {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Range calls fn once for each registered sorter, holding the read lock for
+// the duration of the call. fn must not call back into the registry.
+func (r *Registry[T]) Range(fn func(name string, sorter Sorter[T])) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for name, sorter := range r.sorters {
+		fn(name, sorter)
+	}
+}
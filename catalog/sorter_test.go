@@ -0,0 +1,130 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+)
+
+// mustDate parses a "2006-01-02" date literal, panicking on a malformed
+// test fixture rather than threading an error through every caller.
+func mustDate(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func sampleProducts() []Product {
+	return []Product{
+		{ID: 1, Name: "Zebra Table", Price: 10.00, Created: mustDate("2019-01-04"), SalesCount: 32, ViewsCount: 730},
+		{ID: 2, Name: "Alabaster Table", Price: 10.00, Created: mustDate("2012-01-04"), SalesCount: 301, ViewsCount: 3279},
+		{ID: 3, Name: "Coffee Table", Price: 5.00, Created: mustDate("2014-05-28"), SalesCount: 1048, ViewsCount: 20123},
+		{ID: 4, Name: "Oak Table", Price: 10.00, Created: mustDate("2014-05-28"), SalesCount: 50, ViewsCount: 500},
+	}
+}
+
+func TestBuildCompositeSortsByEachKeyInOrder(t *testing.T) {
+	registry := NewSorterRegistry()
+	sorter, err := BuildComposite(registry, "price,name")
+	if err != nil {
+		t.Fatalf("BuildComposite returned error: %v", err)
+	}
+
+	got := sorter.Sort(sampleProducts())
+	want := []string{"Coffee Table", "Alabaster Table", "Oak Table", "Zebra Table"}
+	for i, name := range want {
+		if got[i].Name != name {
+			t.Errorf("position %d: got %q, want %q", i, got[i].Name, name)
+		}
+	}
+}
+
+func TestBuildCompositeDirectionPrefixes(t *testing.T) {
+	registry := NewSorterRegistry()
+	sorter, err := BuildComposite(registry, "-price,+name")
+	if err != nil {
+		t.Fatalf("BuildComposite returned error: %v", err)
+	}
+
+	got := sorter.Sort(sampleProducts())
+	want := []string{"Alabaster Table", "Oak Table", "Zebra Table", "Coffee Table"}
+	for i, name := range want {
+		if got[i].Name != name {
+			t.Errorf("position %d: got %q, want %q", i, got[i].Name, name)
+		}
+	}
+}
+
+func TestBuildCompositeEchoesNormalizedSpec(t *testing.T) {
+	registry := NewSorterRegistry()
+	sorter, err := BuildComposite(registry, " -Price , Name ")
+	if err != nil {
+		t.Fatalf("BuildComposite returned error: %v", err)
+	}
+
+	if got, want := sorter.GetName(), "-price,name"; got != want {
+		t.Errorf("GetName() = %q, want %q", got, want)
+	}
+}
+
+func TestBuildCompositeRejectsUnknownKey(t *testing.T) {
+	registry := NewSorterRegistry()
+	if _, err := BuildComposite(registry, "bogus"); err == nil {
+		t.Fatal("expected an error for an unknown sort key, got nil")
+	}
+}
+
+func TestBuildCompositeRejectsEmptyToken(t *testing.T) {
+	registry := NewSorterRegistry()
+	if _, err := BuildComposite(registry, "price,,name"); err == nil {
+		t.Fatal("expected an error for an empty token, got nil")
+	}
+}
+
+func TestBuildCompositeRejectsDuplicateKey(t *testing.T) {
+	registry := NewSorterRegistry()
+	if _, err := BuildComposite(registry, "price,-price"); err == nil {
+		t.Fatal("expected an error for a duplicate key, got nil")
+	}
+}
+
+func TestBuildCompositeRejectsEmptySpec(t *testing.T) {
+	registry := NewSorterRegistry()
+	if _, err := BuildComposite(registry, "   "); err == nil {
+		t.Fatal("expected an error for an empty spec, got nil")
+	}
+}
+
+func TestBuildCompositeIsStableOnTies(t *testing.T) {
+	registry := NewSorterRegistry()
+	sorter, err := BuildComposite(registry, "price")
+	if err != nil {
+		t.Fatalf("BuildComposite returned error: %v", err)
+	}
+
+	products := sampleProducts() // first three products tie on price=10.00 except Coffee Table
+	tiedSameOrder := []Product{products[0], products[1], products[3]}
+	got := sorter.Sort(append(append([]Product{}, tiedSameOrder...), products[2]))
+
+	// Coffee Table (cheapest) sorts first; the three tied at $10 keep their
+	// original relative order: Zebra, Alabaster, Oak.
+	want := []string{"Coffee Table", "Zebra Table", "Alabaster Table", "Oak Table"}
+	for i, name := range want {
+		if got[i].Name != name {
+			t.Errorf("position %d: got %q, want %q", i, got[i].Name, name)
+		}
+	}
+}
+
+func TestGetSortedProductsByUsesCompositeSpec(t *testing.T) {
+	catalog := NewProductCatalog(sampleProducts())
+	got, err := catalog.GetSortedProductsBy("-sales")
+	if err != nil {
+		t.Fatalf("GetSortedProductsBy returned error: %v", err)
+	}
+
+	if got[0].Name != "Coffee Table" {
+		t.Errorf("got[0].Name = %q, want %q", got[0].Name, "Coffee Table")
+	}
+}
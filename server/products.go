@@ -0,0 +1,109 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/tdadadavid/scnip/catalog"
+)
+
+// productsResponse is the JSON body returned by GET /products.
+type productsResponse struct {
+	Products []catalog.Product `json:"products"`
+	Total    int               `json:"total"`
+	Sort     string            `json:"sort,omitempty"`
+}
+
+// handleProducts serves GET /products?sort=<spec>&price_min=&price_max=&created_after=&created_before=&limit=&offset=
+func (s *Server) handleProducts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query, err := parseProductQuery(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := s.catalog.Query(query)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, productsResponse{
+		Products: result.Products,
+		Total:    result.Total,
+		Sort:     result.SortName,
+	})
+}
+
+// parseProductQuery builds a catalog.ProductQuery from the request's query parameters.
+func parseProductQuery(r *http.Request) (catalog.ProductQuery, error) {
+	q := r.URL.Query()
+
+	query := catalog.ProductQuery{
+		Sort: q.Get("sort"),
+	}
+
+	priceMin, priceMax := -1.0, -1.0
+	hasPriceFilter := false
+
+	if v := q.Get("price_min"); v != "" {
+		min, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return catalog.ProductQuery{}, fmt.Errorf("invalid price_min %q: %w", v, err)
+		}
+		priceMin = min
+		hasPriceFilter = true
+	}
+	if v := q.Get("price_max"); v != "" {
+		max, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return catalog.ProductQuery{}, fmt.Errorf("invalid price_max %q: %w", v, err)
+		}
+		priceMax = max
+		hasPriceFilter = true
+	}
+	if hasPriceFilter {
+		if priceMin < 0 {
+			priceMin = 0
+		}
+		query.Filters = append(query.Filters, catalog.PriceRangeFilter(priceMin, priceMax))
+	}
+
+	if v := q.Get("created_after"); v != "" {
+		filter, err := catalog.CreatedAfterFilter(v)
+		if err != nil {
+			return catalog.ProductQuery{}, err
+		}
+		query.Filters = append(query.Filters, filter)
+	}
+	if v := q.Get("created_before"); v != "" {
+		filter, err := catalog.CreatedBeforeFilter(v)
+		if err != nil {
+			return catalog.ProductQuery{}, err
+		}
+		query.Filters = append(query.Filters, filter)
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return catalog.ProductQuery{}, fmt.Errorf("invalid limit %q: %w", v, err)
+		}
+		query.Limit = limit
+	}
+	if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil {
+			return catalog.ProductQuery{}, fmt.Errorf("invalid offset %q: %w", v, err)
+		}
+		query.Offset = offset
+	}
+
+	return query, nil
+}
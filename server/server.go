@@ -0,0 +1,49 @@
+// Package server exposes a catalog.ProductCatalog as an HTTP/JSON API.
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tdadadavid/scnip/catalog"
+)
+
+// Server wraps a ProductCatalog and serves it over HTTP.
+type Server struct {
+	catalog *catalog.ProductCatalog
+}
+
+// New creates a Server for the given catalog.
+func New(c *catalog.ProductCatalog) *Server {
+	return &Server{catalog: c}
+}
+
+// Handler returns the http.Handler for the catalog API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/products", s.handleProducts)
+	mux.HandleFunc("/products/sorters", s.handleSorters)
+	return mux
+}
+
+// errorResponse is the JSON body returned alongside non-2xx responses.
+type errorResponse struct {
+	Error            string   `json:"error"`
+	AvailableSorters []string `json:"available_sorters,omitempty"`
+}
+
+// writeJSON encodes v as the response body with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a JSON error body, listing the catalog's available
+// sorters so the client can self-correct an unknown sort name.
+func (s *Server) writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{
+		Error:            err.Error(),
+		AvailableSorters: s.catalog.AvailableSorters(),
+	})
+}
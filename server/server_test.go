@@ -0,0 +1,188 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tdadadavid/scnip/catalog"
+)
+
+// mustDate parses a "2006-01-02" date literal, panicking on a malformed
+// test fixture rather than threading an error through every caller.
+func mustDate(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func sampleCatalog() *catalog.ProductCatalog {
+	return catalog.NewProductCatalog([]catalog.Product{
+		{ID: 1, Name: "Zebra Table", Price: 44.49, Created: mustDate("2012-01-04"), SalesCount: 301, ViewsCount: 3279},
+		{ID: 2, Name: "Alabaster Table", Price: 12.99, Created: mustDate("2019-01-04"), SalesCount: 32, ViewsCount: 730},
+		{ID: 3, Name: "Coffee Table", Price: 10.00, Created: mustDate("2014-05-28"), SalesCount: 1048, ViewsCount: 20123},
+	})
+}
+
+func TestGetProductsSortsAndFilters(t *testing.T) {
+	srv := httptest.NewServer(New(sampleCatalog()).Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/products?sort=price&price_max=20")
+	if err != nil {
+		t.Fatalf("GET /products failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var body productsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	if body.Total != 2 {
+		t.Fatalf("Total = %d, want 2", body.Total)
+	}
+	if len(body.Products) != 2 || body.Products[0].Name != "Coffee Table" {
+		t.Errorf("Products = %+v, want Coffee Table first", body.Products)
+	}
+}
+
+func TestGetProductsRejectsUnknownSort(t *testing.T) {
+	srv := httptest.NewServer(New(sampleCatalog()).Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/products?sort=bogus")
+	if err != nil {
+		t.Fatalf("GET /products failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+
+	var body errorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(body.AvailableSorters) == 0 {
+		t.Error("expected AvailableSorters to be populated on error")
+	}
+}
+
+func TestGetSortersListsDefaults(t *testing.T) {
+	srv := httptest.NewServer(New(sampleCatalog()).Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/products/sorters")
+	if err != nil {
+		t.Fatalf("GET /products/sorters failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body sortersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(body.Sorters) != 3 {
+		t.Errorf("len(Sorters) = %d, want 3", len(body.Sorters))
+	}
+}
+
+func TestPostSortersRegistersAndIsUsable(t *testing.T) {
+	srv := httptest.NewServer(New(sampleCatalog()).Handler())
+	defer srv.Close()
+
+	reqBody, _ := json.Marshal(registerSorterRequest{Name: "cheapest-first", Spec: "price,name"})
+	resp, err := http.Post(srv.URL+"/products/sorters", "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		t.Fatalf("POST /products/sorters failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+
+	sortResp, err := http.Get(srv.URL + "/products?sort=cheapest-first")
+	if err != nil {
+		t.Fatalf("GET /products failed: %v", err)
+	}
+	defer sortResp.Body.Close()
+
+	if sortResp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", sortResp.StatusCode, http.StatusOK)
+	}
+
+	var body productsResponse
+	if err := json.NewDecoder(sortResp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if body.Products[0].Name != "Coffee Table" {
+		t.Errorf("Products[0].Name = %q, want %q", body.Products[0].Name, "Coffee Table")
+	}
+}
+
+func TestPostSortersRejectsEmptyName(t *testing.T) {
+	srv := httptest.NewServer(New(sampleCatalog()).Handler())
+	defer srv.Close()
+
+	reqBody, _ := json.Marshal(registerSorterRequest{Spec: "price"})
+	resp, err := http.Post(srv.URL+"/products/sorters", "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		t.Fatalf("POST /products/sorters failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+// TestConcurrentGetAndPostSortersIsRaceFree registers sorters from several
+// goroutines while others list them, guarding against the registry's
+// underlying map being read and written without synchronization (run with
+// -race to catch a regression).
+func TestConcurrentGetAndPostSortersIsRaceFree(t *testing.T) {
+	srv := httptest.NewServer(New(sampleCatalog()).Handler())
+	defer srv.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			reqBody, _ := json.Marshal(registerSorterRequest{
+				Name: fmt.Sprintf("custom-%d", i),
+				Spec: "price,name",
+			})
+			resp, err := http.Post(srv.URL+"/products/sorters", "application/json", bytes.NewReader(reqBody))
+			if err != nil {
+				t.Errorf("POST /products/sorters failed: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}(i)
+		go func() {
+			defer wg.Done()
+			resp, err := http.Get(srv.URL + "/products/sorters")
+			if err != nil {
+				t.Errorf("GET /products/sorters failed: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// sorterInfo describes one available sorter for GET /products/sorters.
+//
+// The catalog only tracks a sorter's name today, so Description simply
+// echoes it; this gives the response room to grow a richer description
+// later without another breaking API change.
+type sorterInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// sortersResponse is the JSON body returned by GET /products/sorters.
+type sortersResponse struct {
+	Sorters []sorterInfo `json:"sorters"`
+}
+
+// registerSorterRequest is the JSON body accepted by POST /products/sorters.
+// Spec uses the same composite sort syntax as the sort query parameter
+// (see catalog.BuildComposite), so a plugin can declare a new named sorter
+// without shipping Go code.
+type registerSorterRequest struct {
+	Name string `json:"name"`
+	Spec string `json:"spec"`
+}
+
+// handleSorters serves GET and POST /products/sorters.
+func (s *Server) handleSorters(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listSorters(w, r)
+	case http.MethodPost:
+		s.registerSorter(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) listSorters(w http.ResponseWriter, _ *http.Request) {
+	names := s.catalog.AvailableSorters()
+	sorters := make([]sorterInfo, 0, len(names))
+	for _, name := range names {
+		sorters = append(sorters, sorterInfo{Name: name, Description: name})
+	}
+
+	writeJSON(w, http.StatusOK, sortersResponse{Sorters: sorters})
+}
+
+func (s *Server) registerSorter(w http.ResponseWriter, r *http.Request) {
+	var req registerSorterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+	if req.Name == "" {
+		s.writeError(w, http.StatusBadRequest, fmt.Errorf("name must not be empty"))
+		return
+	}
+
+	if err := s.catalog.RegisterCompositeSorter(req.Name, req.Spec); err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, sorterInfo{Name: req.Name, Description: req.Name})
+}